@@ -0,0 +1,148 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gotcha/color"
+)
+
+func init() {
+	Register(linuxBatteryCollector{})
+}
+
+// linuxBatteryCollector enumerates every /sys/class/power_supply/BAT*
+// entry plus AC adapter presence.
+type linuxBatteryCollector struct{}
+
+func (linuxBatteryCollector) Name() string { return "battery" }
+
+func (linuxBatteryCollector) Supported() bool { return true }
+
+func (linuxBatteryCollector) Collect(ctx context.Context) (Field, error) {
+	batteries, err := BatterySnapshot(ctx)
+	if err != nil {
+		return Field{}, err
+	}
+	if len(batteries) == 0 {
+		return Field{Name: "battery", Text: unknown}, nil
+	}
+
+	parts := make([]string, 0, len(batteries)+1)
+	for _, b := range batteries {
+		parts = append(parts, describeBattery(b))
+	}
+	if acOnline() {
+		parts = append(parts, "AC connected")
+	}
+
+	return Field{Name: "battery", Text: strings.Join(parts, ", ")}, nil
+}
+
+func describeBattery(b BatteryStats) string {
+	var capColor string
+	switch {
+	case b.CapacityPct < 20:
+		capColor = color.BrightRed
+	case b.CapacityPct < 50:
+		capColor = color.BrightYellow
+	default:
+		capColor = color.BrightGreen
+	}
+
+	text := fmt.Sprintf("%s: %s (%s)", b.Name,
+		color.Colorize(fmt.Sprintf("%.0f%%", b.CapacityPct), capColor), b.Status)
+
+	if b.HealthPct > 0 {
+		text += fmt.Sprintf(", %.0f%% health", b.HealthPct)
+	}
+	if b.TimeToStateSecs > 0 {
+		switch b.Status {
+		case "Charging":
+			text += fmt.Sprintf(", %s to full", formatDuration(b.TimeToStateSecs))
+		case "Discharging":
+			text += fmt.Sprintf(", %s remaining", formatDuration(b.TimeToStateSecs))
+		}
+	}
+
+	return text
+}
+
+// BatterySnapshot returns the typed per-battery stats consumed by the
+// render package's structured formats.
+func BatterySnapshot(ctx context.Context) ([]BatteryStats, error) {
+	dirs, err := filepath.Glob("/sys/class/power_supply/BAT*")
+	if err != nil {
+		return nil, err
+	}
+
+	var batteries []BatteryStats
+	for _, dir := range dirs {
+		b, ok := readBattery(dir)
+		if ok {
+			batteries = append(batteries, b)
+		}
+	}
+
+	return batteries, nil
+}
+
+func readBattery(dir string) (BatteryStats, bool) {
+	capacity, err := readUintFile(filepath.Join(dir, "capacity"))
+	if err != nil {
+		return BatteryStats{}, false
+	}
+
+	status := "unknown"
+	if data, err := os.ReadFile(filepath.Join(dir, "status")); err == nil {
+		status = strings.TrimSpace(string(data))
+	}
+
+	b := BatteryStats{
+		Name:        filepath.Base(dir),
+		CapacityPct: float64(capacity),
+		Status:      status,
+	}
+
+	energyNow, errNow := readUintFile(filepath.Join(dir, "energy_now"))
+	energyFull, errFull := readUintFile(filepath.Join(dir, "energy_full"))
+	energyFullDesign, errDesign := readUintFile(filepath.Join(dir, "energy_full_design"))
+	powerNow, errPower := readUintFile(filepath.Join(dir, "power_now"))
+
+	if errFull == nil && errDesign == nil && energyFullDesign > 0 {
+		b.HealthPct = float64(energyFull) / float64(energyFullDesign) * 100
+	}
+
+	if errNow == nil && errFull == nil && errPower == nil && powerNow > 0 {
+		var remainingMicroWh uint64
+		switch status {
+		case "Charging":
+			remainingMicroWh = energyFull - energyNow
+		case "Discharging":
+			remainingMicroWh = energyNow
+		}
+		if remainingMicroWh > 0 {
+			hours := float64(remainingMicroWh) / float64(powerNow)
+			b.TimeToStateSecs = int(hours * 3600)
+		}
+	}
+
+	return b, true
+}
+
+func acOnline() bool {
+	dirs, err := filepath.Glob("/sys/class/power_supply/AC*")
+	if err != nil {
+		return false
+	}
+	for _, dir := range dirs {
+		if v, err := readUintFile(filepath.Join(dir, "online")); err == nil && v == 1 {
+			return true
+		}
+	}
+	return false
+}
+
@@ -0,0 +1,26 @@
+//go:build !linux
+
+package collectors
+
+import "context"
+
+func init() {
+	Register(unsupportedBatteryCollector{})
+}
+
+// unsupportedBatteryCollector is registered on non-Linux platforms;
+// gotcha has no battery probe for macOS/Windows yet.
+type unsupportedBatteryCollector struct{}
+
+func (unsupportedBatteryCollector) Name() string { return "battery" }
+
+func (unsupportedBatteryCollector) Supported() bool { return false }
+
+func (unsupportedBatteryCollector) Collect(ctx context.Context) (Field, error) {
+	return Field{}, errNotFound
+}
+
+// BatterySnapshot reports no batteries on platforms without a probe.
+func BatterySnapshot(ctx context.Context) ([]BatteryStats, error) {
+	return nil, nil
+}
@@ -0,0 +1,58 @@
+// Package collectors provides the pluggable Collector interface used to
+// gather host information. Each metric (cpu, memory, disks, ...) is
+// implemented by one or more backends registered via build tags: a Linux
+// backend that parses /proc and /sys directly, and a cross-platform
+// backend built on gopsutil for macOS, FreeBSD, and Windows.
+package collectors
+
+import (
+	"context"
+	"errors"
+)
+
+var errNotFound = errors.New("not found")
+
+// Field is a single named piece of host information ready for display.
+type Field struct {
+	Name string
+	Text string
+}
+
+// Collector produces one Field of host information.
+type Collector interface {
+	// Name identifies the collector. It is used for DISABLE= filtering
+	// and should match the Field's Name returned from Collect.
+	Name() string
+	// Collect gathers the metric and returns it as a Field. It should
+	// respect ctx cancellation for backends that shell out or block.
+	Collect(ctx context.Context) (Field, error)
+	// Supported reports whether this backend can run on the current
+	// platform/host, e.g. the non-Linux battery, GPU and package-count
+	// stubs return false.
+	Supported() bool
+}
+
+var registry []Collector
+
+// Register adds a collector to the default registry. Backends call this
+// from an init() guarded by build tags so only the collectors compiled
+// for the current platform are registered.
+func Register(c Collector) {
+	registry = append(registry, c)
+}
+
+// All returns every registered collector that reports itself supported
+// on this host and is not filtered out by isDisabled.
+func All(isDisabled func(name string) bool) []Collector {
+	var out []Collector
+	for _, c := range registry {
+		if !c.Supported() {
+			continue
+		}
+		if isDisabled != nil && isDisabled(c.Name()) {
+			continue
+		}
+		out = append(out, c)
+	}
+	return out
+}
@@ -0,0 +1,6 @@
+package collectors
+
+// Config is the program's key/value config, populated by main before any
+// collector runs. It mirrors the package-level config map in package
+// main; collectors read from here instead of reaching back into main.
+var Config map[string]string
@@ -0,0 +1,315 @@
+package collectors
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gotcha/color"
+)
+
+func init() {
+	Register(linuxCPUCollector{})
+}
+
+// linuxCPUCollector reports the CPU model name, core counts, current/max
+// frequency, load average, average CPU temperature, and overall
+// utilization. Temperatures are averaged across the CPU's sensors; the
+// reading of each core is not reported separately.
+type linuxCPUCollector struct{}
+
+func (linuxCPUCollector) Name() string { return "cpu" }
+
+func (linuxCPUCollector) Supported() bool { return true }
+
+func (linuxCPUCollector) Collect(ctx context.Context) (Field, error) {
+	info, err := CPUSnapshot(ctx)
+	if err != nil {
+		return Field{}, err
+	}
+
+	parts := []string{info.Model}
+
+	if info.PhysicalCores > 0 {
+		parts = append(parts, fmt.Sprintf("%d cores (%d threads)", info.PhysicalCores, info.LogicalCores))
+	}
+
+	if info.MaxMHz > 0 {
+		parts = append(parts, fmt.Sprintf("%d/%d MHz", info.CurrentMHz, info.MaxMHz))
+	}
+
+	if info.LogicalCores > 0 {
+		parts = append(parts, fmt.Sprintf("%s util", colorizePct(info.UtilizationPct)))
+	}
+
+	if info.Load1 > 0 || info.Load5 > 0 || info.Load15 > 0 {
+		pctOfCores := 0.0
+		if info.LogicalCores > 0 {
+			pctOfCores = info.Load1 / float64(info.LogicalCores) * 100
+		}
+		load := fmt.Sprintf("%.2f %.2f %.2f", info.Load1, info.Load5, info.Load15)
+		parts = append(parts, fmt.Sprintf("load %s", colorizeByPct(load, pctOfCores)))
+	}
+
+	if temps := coreTemperatures(); temps != "" {
+		parts = append(parts, temps)
+	}
+
+	return Field{Name: "cpu", Text: strings.Join(parts, ", ")}, nil
+}
+
+// CPUSnapshot returns the typed CPU info consumed by the render
+// package's structured formats.
+func CPUSnapshot(ctx context.Context) (CPUInfo, error) {
+	model, err := cpuModel()
+	if err != nil {
+		return CPUInfo{}, err
+	}
+
+	info := CPUInfo{Model: model}
+
+	if logical, physical, err := cpuCoreCounts(); err == nil {
+		info.LogicalCores, info.PhysicalCores = logical, physical
+	}
+	if cur, max, err := cpuFreqMHz(); err == nil {
+		info.CurrentMHz, info.MaxMHz = cur, max
+	}
+	if util, err := cpuUtilization(ctx); err == nil {
+		info.UtilizationPct = util
+	}
+	if l1, l5, l15, ok := loadAverageValues(); ok {
+		info.Load1, info.Load5, info.Load15 = l1, l5, l15
+	}
+
+	return info, nil
+}
+
+func cpuModel() (string, error) {
+	cpuinfo, err := os.ReadFile("/proc/cpuinfo")
+	if err != nil {
+		return "", err
+	}
+
+	for line := range bytes.SplitSeq(cpuinfo, []byte("\n")) {
+		if out, ok := bytes.CutPrefix(line, []byte("model name\t:")); ok {
+			return strings.TrimSpace(string(out)), nil
+		}
+	}
+
+	return "", errNotFound
+}
+
+// cpuCoreCounts returns the logical (thread) and physical core counts
+// parsed from /proc/cpuinfo.
+func cpuCoreCounts() (logical, physical int, err error) {
+	cpuinfo, err := os.ReadFile("/proc/cpuinfo")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	physicalCores := make(map[string]struct{})
+	var curPhysicalID, curCoreID string
+
+	for line := range strings.SplitSeq(string(cpuinfo), "\n") {
+		switch {
+		case strings.HasPrefix(line, "processor"):
+			logical++
+		case strings.HasPrefix(line, "physical id"):
+			curPhysicalID = lastField(line)
+		case strings.HasPrefix(line, "core id"):
+			curCoreID = lastField(line)
+			if curPhysicalID != "" {
+				physicalCores[curPhysicalID+"/"+curCoreID] = struct{}{}
+			}
+		}
+	}
+
+	if logical == 0 {
+		return 0, 0, errNotFound
+	}
+	if len(physicalCores) == 0 {
+		physical = logical
+	} else {
+		physical = len(physicalCores)
+	}
+
+	return logical, physical, nil
+}
+
+func lastField(line string) string {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[len(fields)-1]
+}
+
+// cpuFreqMHz reads cpu0's current scaling frequency and its hardware
+// maximum frequency (cpuinfo_max_freq) in MHz.
+func cpuFreqMHz() (cur, max int, err error) {
+	const base = "/sys/devices/system/cpu/cpu0/cpufreq"
+
+	curKHz, err := readUintFile(filepath.Join(base, "scaling_cur_freq"))
+	if err != nil {
+		return 0, 0, err
+	}
+	maxKHz, err := readUintFile(filepath.Join(base, "cpuinfo_max_freq"))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int(curKHz / 1000), int(maxKHz / 1000), nil
+}
+
+func readUintFile(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// loadAverageValues reports the 1/5/15 minute load average from
+// /proc/loadavg.
+func loadAverageValues() (one, five, fifteen float64, ok bool) {
+	data, err := os.ReadFile("/proc/loadavg")
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) < 3 {
+		return 0, 0, 0, false
+	}
+
+	one, _ = strconv.ParseFloat(fields[0], 64)
+	five, _ = strconv.ParseFloat(fields[1], 64)
+	fifteen, _ = strconv.ParseFloat(fields[2], 64)
+
+	return one, five, fifteen, true
+}
+
+// cpuUtilization samples /proc/stat twice with a short delta and returns
+// the overall (idle+iowait vs total jiffies) utilization percent.
+func cpuUtilization(ctx context.Context) (float64, error) {
+	first, err := readCPUJiffies()
+	if err != nil {
+		return 0, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	second, err := readCPUJiffies()
+	if err != nil {
+		return 0, err
+	}
+
+	totalDelta := second.total() - first.total()
+	if totalDelta <= 0 {
+		return 0, errNotFound
+	}
+	idleDelta := (second.idle + second.iowait) - (first.idle + first.iowait)
+
+	return 100 * (1 - float64(idleDelta)/float64(totalDelta)), nil
+}
+
+type cpuJiffies struct {
+	user, nice, system, idle, iowait, irq, softirq, steal uint64
+}
+
+func (j cpuJiffies) total() uint64 {
+	return j.user + j.nice + j.system + j.idle + j.iowait + j.irq + j.softirq + j.steal
+}
+
+func readCPUJiffies() (cpuJiffies, error) {
+	data, err := os.ReadFile("/proc/stat")
+	if err != nil {
+		return cpuJiffies{}, err
+	}
+
+	for line := range strings.SplitSeq(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 9 || fields[0] != "cpu" {
+			continue
+		}
+		vals := make([]uint64, 8)
+		for i := range vals {
+			vals[i], _ = strconv.ParseUint(fields[i+1], 10, 64)
+		}
+		return cpuJiffies{
+			user: vals[0], nice: vals[1], system: vals[2], idle: vals[3],
+			iowait: vals[4], irq: vals[5], softirq: vals[6], steal: vals[7],
+		}, nil
+	}
+
+	return cpuJiffies{}, errNotFound
+}
+
+// cpuHwmonNames lists the hwmon driver names that expose the CPU die's
+// own temperature, as opposed to GPU/NVMe/chipset sensors that also
+// register under /sys/class/hwmon.
+var cpuHwmonNames = []string{"coretemp", "k10temp", "zenpower", "cpu_thermal"}
+
+// coreTemperatures averages the temp*_input readings from the CPU's own
+// hwmon chip(s) (identified by name, see cpuHwmonNames) and colors the
+// result by the same 50/80 thresholds parseMeminfo uses. Readings from
+// unrelated hwmon chips (GPU, NVMe, chipset) are excluded.
+func coreTemperatures() string {
+	chips, err := filepath.Glob("/sys/class/hwmon/*")
+	if err != nil || len(chips) == 0 {
+		return ""
+	}
+
+	var sum, count int64
+	for _, chip := range chips {
+		name, err := os.ReadFile(filepath.Join(chip, "name"))
+		if err != nil || !slices.Contains(cpuHwmonNames, strings.TrimSpace(string(name))) {
+			continue
+		}
+
+		inputs, err := filepath.Glob(filepath.Join(chip, "temp*_input"))
+		if err != nil {
+			continue
+		}
+		for _, in := range inputs {
+			v, err := readUintFile(in)
+			if err != nil {
+				continue
+			}
+			sum += int64(v)
+			count++
+		}
+	}
+	if count == 0 {
+		return ""
+	}
+
+	celsius := float64(sum) / float64(count) / 1000
+	return colorizeByPct(fmt.Sprintf("%.1f°C", celsius), celsius) + " avg temp"
+}
+
+func colorizePct(pct float64) string {
+	return colorizeByPct(fmt.Sprintf("%.1f%%", pct), pct)
+}
+
+func colorizeByPct(text string, pct float64) string {
+	var c string
+	switch {
+	case pct >= 80:
+		c = color.BrightRed
+	case pct >= 50:
+		c = color.BrightYellow
+	default:
+		c = color.BrightGreen
+	}
+	return color.Colorize(text, c)
+}
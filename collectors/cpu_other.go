@@ -0,0 +1,83 @@
+//go:build !linux
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/load"
+)
+
+func init() {
+	Register(gopsutilCPUCollector{})
+}
+
+// gopsutilCPUCollector reads CPU model, core counts, utilization, and
+// load average via gopsutil, backing macOS, FreeBSD, and Windows.
+type gopsutilCPUCollector struct{}
+
+func (gopsutilCPUCollector) Name() string { return "cpu" }
+
+func (gopsutilCPUCollector) Supported() bool { return true }
+
+func (gopsutilCPUCollector) Collect(ctx context.Context) (Field, error) {
+	info, err := CPUSnapshot(ctx)
+	if err != nil {
+		return Field{}, err
+	}
+
+	text := fmt.Sprintf("%s, %d cores (%d threads), %s util",
+		info.Model, info.PhysicalCores, info.LogicalCores, colorizePct(info.UtilizationPct))
+	if info.Load1 > 0 || info.Load5 > 0 || info.Load15 > 0 {
+		pctOfCores := 0.0
+		if info.LogicalCores > 0 {
+			pctOfCores = info.Load1 / float64(info.LogicalCores) * 100
+		}
+		loadText := fmt.Sprintf("%.2f %.2f %.2f", info.Load1, info.Load5, info.Load15)
+		text += fmt.Sprintf(", load %s", colorizeByPct(loadText, pctOfCores))
+	}
+
+	return Field{Name: "cpu", Text: text}, nil
+}
+
+// CPUSnapshot returns the typed CPU info consumed by the render
+// package's structured formats.
+func CPUSnapshot(ctx context.Context) (CPUInfo, error) {
+	infos, err := cpu.InfoWithContext(ctx)
+	if err != nil {
+		return CPUInfo{}, err
+	}
+	if len(infos) == 0 {
+		return CPUInfo{}, errNotFound
+	}
+
+	physical, err := cpu.CountsWithContext(ctx, false)
+	if err != nil {
+		physical = len(infos)
+	}
+	logical, err := cpu.CountsWithContext(ctx, true)
+	if err != nil {
+		logical = len(infos)
+	}
+
+	result := CPUInfo{
+		Model:         infos[0].ModelName,
+		PhysicalCores: physical,
+		LogicalCores:  logical,
+		MaxMHz:        int(infos[0].Mhz),
+		CurrentMHz:    int(infos[0].Mhz),
+	}
+
+	if pcts, err := cpu.PercentWithContext(ctx, 100*time.Millisecond, false); err == nil && len(pcts) > 0 {
+		result.UtilizationPct = pcts[0]
+	}
+
+	if avg, err := load.AvgWithContext(ctx); err == nil {
+		result.Load1, result.Load5, result.Load15 = avg.Load1, avg.Load5, avg.Load15
+	}
+
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package collectors
+
+import (
+	"fmt"
+	"strings"
+
+	"gotcha/color"
+)
+
+// DiskUsage holds the usage and, where available, I/O stats for a single
+// mount point.
+type DiskUsage struct {
+	MountPoint   string
+	Used         uint64
+	Total        uint64
+	UsedPct      float64
+	ReadsPerSec  float64
+	WritesPerSec float64
+	IOUtilPct    float64
+	HasIOStats   bool
+}
+
+// formatDiskUsage renders a slice of DiskUsage the way GetDisksUsage did,
+// one "mount: used / total (pct used)" line per mount, colored with the
+// same thresholds as parseMeminfo, plus per-disk I/O stats when present.
+func formatDiskUsage(usages []DiskUsage) string {
+	if len(usages) == 0 {
+		return unknown
+	}
+
+	lines := make([]string, 0, len(usages))
+	for _, u := range usages {
+		var usageColor string
+		switch {
+		case u.UsedPct >= 80:
+			usageColor = color.BrightRed
+		case u.UsedPct >= 50:
+			usageColor = color.BrightYellow
+		default:
+			usageColor = color.BrightGreen
+		}
+
+		line := fmt.Sprintf("%s: %s / %s (%s used)",
+			u.MountPoint,
+			humanBytes(u.Used),
+			humanBytes(u.Total),
+			color.Colorize(fmt.Sprintf("%.1f%%", u.UsedPct), usageColor),
+		)
+		if u.HasIOStats {
+			line += fmt.Sprintf(", %.1f r/s, %.1f w/s, %s util",
+				u.ReadsPerSec, u.WritesPerSec, colorizeByPct(fmt.Sprintf("%.1f%%", u.IOUtilPct), u.IOUtilPct))
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, ", ")
+}
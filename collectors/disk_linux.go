@@ -0,0 +1,161 @@
+package collectors
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const diskSampleIntervalMs = 200
+
+func init() {
+	Register(linuxDiskCollector{})
+}
+
+// linuxDiskCollector statfs()s every mount in config["MOUNTS"] (default
+// "/boot,/") and samples /proc/diskstats twice to add I/O rates.
+type linuxDiskCollector struct{}
+
+func (linuxDiskCollector) Name() string { return "disks" }
+
+func (linuxDiskCollector) Supported() bool { return true }
+
+func (linuxDiskCollector) Collect(ctx context.Context) (Field, error) {
+	usages, err := DiskSnapshot(ctx)
+	if err != nil {
+		return Field{}, err
+	}
+
+	return Field{Name: "disks", Text: formatDiskUsage(usages)}, nil
+}
+
+// DiskSnapshot returns the typed per-mount usage, including I/O rates,
+// consumed by the render package's structured formats.
+func DiskSnapshot(ctx context.Context) ([]DiskUsage, error) {
+	usages := linuxDiskUsage()
+
+	before, err := readDiskStats()
+	if err == nil {
+		select {
+		case <-ctx.Done():
+			return usages, ctx.Err()
+		case <-time.After(diskSampleIntervalMs * time.Millisecond):
+		}
+
+		if after, err := readDiskStats(); err == nil {
+			applyIOStats(usages, before, after, diskSampleIntervalMs)
+		}
+	}
+
+	return usages, nil
+}
+
+func linuxDiskUsage() []DiskUsage {
+	mounts := Config["MOUNTS"]
+	if mounts == "" {
+		mounts = "/boot,/"
+	}
+	var results []DiskUsage
+
+	for mount := range strings.SplitSeq(mounts, ",") {
+		stat := syscall.Statfs_t{}
+		if err := syscall.Statfs(mount, &stat); err == nil {
+			totalSpace := uint64(stat.Blocks) * uint64(stat.Bsize)
+			occupiedSpace := totalSpace - (uint64(stat.Bfree) * uint64(stat.Bsize))
+			results = append(results, DiskUsage{
+				MountPoint: mount,
+				Used:       occupiedSpace,
+				Total:      totalSpace,
+				UsedPct:    float64(occupiedSpace) / float64(totalSpace) * 100,
+			})
+		}
+	}
+
+	return results
+}
+
+type diskStat struct {
+	reads, writes uint64
+	ioTicksMs     uint64
+}
+
+// readDiskStats parses /proc/diskstats into per-device counters, keyed
+// by kernel device name (e.g. "sda1").
+func readDiskStats() (map[string]diskStat, error) {
+	data, err := os.ReadFile("/proc/diskstats")
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]diskStat)
+	for line := range strings.SplitSeq(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 13 {
+			continue
+		}
+		name := fields[2]
+		reads, _ := strconv.ParseUint(fields[3], 10, 64)
+		writes, _ := strconv.ParseUint(fields[7], 10, 64)
+		ioTicks, _ := strconv.ParseUint(fields[12], 10, 64)
+		out[name] = diskStat{reads: reads, writes: writes, ioTicksMs: ioTicks}
+	}
+
+	return out, nil
+}
+
+// applyIOStats fills in the I/O fields of each DiskUsage by resolving its
+// mount point to a backing device and diffing two diskStat samples taken
+// intervalMs apart.
+func applyIOStats(usages []DiskUsage, before, after map[string]diskStat, intervalMs float64) {
+	devices := mountDevicesFn()
+
+	for i := range usages {
+		dev, ok := devices[usages[i].MountPoint]
+		if !ok {
+			continue
+		}
+		b, okB := before[dev]
+		a, okA := after[dev]
+		if !okB || !okA {
+			continue
+		}
+
+		secs := intervalMs / 1000
+		usages[i].ReadsPerSec = float64(a.reads-b.reads) / secs
+		usages[i].WritesPerSec = float64(a.writes-b.writes) / secs
+		usages[i].IOUtilPct = float64(a.ioTicksMs-b.ioTicksMs) / (intervalMs) * 100
+		usages[i].HasIOStats = true
+	}
+}
+
+// mountDevicesFn is mountDevices by default; swapped out in tests so
+// applyIOStats can be exercised without a real /proc/mounts.
+var mountDevicesFn = mountDevices
+
+// mountDevices maps each mount point in /proc/mounts to its backing
+// kernel device name (e.g. "/" -> "sda1").
+func mountDevices() map[string]string {
+	data, err := os.ReadFile("/proc/mounts")
+	if err != nil {
+		return nil
+	}
+
+	out := make(map[string]string)
+	for line := range strings.SplitSeq(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		dev, mount := fields[0], fields[1]
+		idx := strings.LastIndex(dev, "/")
+		if idx == -1 {
+			continue
+		}
+		out[mount] = dev[idx+1:]
+	}
+
+	return out
+}
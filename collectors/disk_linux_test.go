@@ -0,0 +1,51 @@
+package collectors
+
+import "testing"
+
+func TestApplyIOStats(t *testing.T) {
+	usages := []DiskUsage{{MountPoint: "/"}}
+	before := map[string]diskStat{"sda1": {reads: 1000, writes: 500, ioTicksMs: 2000}}
+	after := map[string]diskStat{"sda1": {reads: 1005, writes: 510, ioTicksMs: 2050}}
+
+	withDevices(t, map[string]string{"/": "sda1"}, func() {
+		applyIOStats(usages, before, after, 200)
+	})
+
+	if !usages[0].HasIOStats {
+		t.Fatal("HasIOStats = false, want true")
+	}
+	if got, want := usages[0].ReadsPerSec, 25.0; got != want {
+		t.Errorf("ReadsPerSec = %v, want %v", got, want)
+	}
+	if got, want := usages[0].WritesPerSec, 50.0; got != want {
+		t.Errorf("WritesPerSec = %v, want %v", got, want)
+	}
+	if got, want := usages[0].IOUtilPct, 25.0; got != want {
+		t.Errorf("IOUtilPct = %v, want %v", got, want)
+	}
+}
+
+func TestApplyIOStatsUnknownDevice(t *testing.T) {
+	usages := []DiskUsage{{MountPoint: "/data"}}
+	before := map[string]diskStat{"sda1": {reads: 1000}}
+	after := map[string]diskStat{"sda1": {reads: 1005}}
+
+	withDevices(t, map[string]string{}, func() {
+		applyIOStats(usages, before, after, 200)
+	})
+
+	if usages[0].HasIOStats {
+		t.Error("HasIOStats = true for a mount with no resolvable device, want false")
+	}
+}
+
+// withDevices stubs mountDevices for the duration of fn, since
+// applyIOStats resolves mount points to kernel device names by reading
+// /proc/mounts.
+func withDevices(t *testing.T, devices map[string]string, fn func()) {
+	t.Helper()
+	orig := mountDevicesFn
+	mountDevicesFn = func() map[string]string { return devices }
+	defer func() { mountDevicesFn = orig }()
+	fn()
+}
@@ -0,0 +1,56 @@
+//go:build !linux
+
+package collectors
+
+import (
+	"context"
+	"strings"
+
+	"github.com/shirou/gopsutil/v3/disk"
+)
+
+func init() {
+	Register(gopsutilDiskCollector{})
+}
+
+// gopsutilDiskCollector reports usage for every mount in
+// config["MOUNTS"] via gopsutil, defaulting to "/" when unset.
+type gopsutilDiskCollector struct{}
+
+func (gopsutilDiskCollector) Name() string { return "disks" }
+
+func (gopsutilDiskCollector) Supported() bool { return true }
+
+func (gopsutilDiskCollector) Collect(ctx context.Context) (Field, error) {
+	results, err := DiskSnapshot(ctx)
+	if err != nil {
+		return Field{}, err
+	}
+
+	return Field{Name: "disks", Text: formatDiskUsage(results)}, nil
+}
+
+// DiskSnapshot returns the typed per-mount usage consumed by the render
+// package's structured formats.
+func DiskSnapshot(ctx context.Context) ([]DiskUsage, error) {
+	mounts := Config["MOUNTS"]
+	if mounts == "" {
+		mounts = "/"
+	}
+
+	var results []DiskUsage
+	for mount := range strings.SplitSeq(mounts, ",") {
+		usage, err := disk.UsageWithContext(ctx, mount)
+		if err != nil {
+			continue
+		}
+		results = append(results, DiskUsage{
+			MountPoint: mount,
+			Used:       usage.Used,
+			Total:      usage.Total,
+			UsedPct:    usage.UsedPercent,
+		})
+	}
+
+	return results, nil
+}
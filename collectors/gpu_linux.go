@@ -0,0 +1,92 @@
+package collectors
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gotcha/pciids"
+)
+
+const (
+	vgaClassCode   = "0x030000\n"
+	pciDevicesPath = "/sys/bus/pci/devices/"
+)
+
+func init() {
+	Register(linuxGPUCollector{})
+}
+
+// linuxGPUCollector finds the first VGA-class PCI device under
+// /sys/bus/pci/devices and resolves its name from pci.ids, including the
+// subsystem (board) name when one is available.
+type linuxGPUCollector struct{}
+
+func (linuxGPUCollector) Name() string { return "gpu" }
+
+func (linuxGPUCollector) Supported() bool { return true }
+
+func (linuxGPUCollector) Collect(ctx context.Context) (Field, error) {
+	deviceDirs, err := os.ReadDir(pciDevicesPath)
+	if err != nil {
+		return Field{}, err
+	}
+
+	for _, dir := range deviceDirs {
+		devicePath := filepath.Join(pciDevicesPath, dir.Name())
+		classContent, err := os.ReadFile(filepath.Join(devicePath, "class"))
+		if err != nil {
+			continue
+		}
+		if !bytes.HasPrefix(classContent, []byte(vgaClassCode)) {
+			continue
+		}
+
+		ueventContent, err := os.ReadFile(filepath.Join(devicePath, "uevent"))
+		if err != nil {
+			return Field{}, err
+		}
+		for line := range strings.SplitSeq(string(ueventContent), "\n") {
+			id, hasID := strings.CutPrefix(line, "PCI_ID=")
+			if !hasID {
+				continue
+			}
+			parts := strings.Split(strings.ToLower(id), ":")
+			vendor, err := parsePCIHex(parts[0])
+			if err != nil {
+				return Field{}, err
+			}
+			device, err := parsePCIHex(parts[1])
+			if err != nil {
+				return Field{}, err
+			}
+
+			subvendor, _ := parsePCIHex(sysfsHex(devicePath, "subsystem_vendor"))
+			subdevice, _ := parsePCIHex(sysfsHex(devicePath, "subsystem_device"))
+
+			name, err := pciids.GetDeviceName(vendor, device, subvendor, subdevice)
+			if err != nil {
+				return Field{}, err
+			}
+			return Field{Name: "gpu", Text: name}, nil
+		}
+	}
+
+	return Field{}, errNotFound
+}
+
+func sysfsHex(devicePath, file string) string {
+	data, err := os.ReadFile(filepath.Join(devicePath, file))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.TrimSpace(string(data)), "0x")
+}
+
+func parsePCIHex(s string) (uint16, error) {
+	v, err := strconv.ParseUint(s, 16, 16)
+	return uint16(v), err
+}
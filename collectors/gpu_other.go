@@ -0,0 +1,22 @@
+//go:build !linux
+
+package collectors
+
+import "context"
+
+func init() {
+	Register(unsupportedGPUCollector{})
+}
+
+// unsupportedGPUCollector is registered on non-Linux platforms where
+// gotcha has no PCI device database to resolve a GPU name from; gopsutil
+// does not expose one either.
+type unsupportedGPUCollector struct{}
+
+func (unsupportedGPUCollector) Name() string { return "gpu" }
+
+func (unsupportedGPUCollector) Supported() bool { return false }
+
+func (unsupportedGPUCollector) Collect(ctx context.Context) (Field, error) {
+	return Field{}, errNotFound
+}
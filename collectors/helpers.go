@@ -0,0 +1,42 @@
+package collectors
+
+import (
+	"fmt"
+
+	"gotcha/color"
+)
+
+var unknown string = color.Colorize("unknown", color.Red)
+
+// formatDuration mirrors FormatDuration in package main.
+func formatDuration(secs int) string {
+	if secs < 0 {
+		return "unknown duration"
+	}
+
+	h := secs / 3600
+	m := (secs % 3600) / 60
+	s := secs % 60
+
+	return fmt.Sprintf("%dh %dm %ds", h, m, s)
+}
+
+// humanBytes formats a byte count the same way HumanBytes in package main
+// does; it is kept package-local so collectors has no dependency back on
+// main.
+func humanBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+
+	div, exp := unit, 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	pre := "KMGTPE"[exp]
+
+	return fmt.Sprintf("%.1f %cB", float64(b)/float64(div), pre)
+}
@@ -0,0 +1,36 @@
+package collectors
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+func init() {
+	Register(linuxKernelCollector{})
+}
+
+// linuxKernelCollector reads the kernel version from /proc/version,
+// falling back to `uname -r` if that's unavailable.
+type linuxKernelCollector struct{}
+
+func (linuxKernelCollector) Name() string { return "kernel" }
+
+func (linuxKernelCollector) Supported() bool { return true }
+
+func (linuxKernelCollector) Collect(ctx context.Context) (Field, error) {
+	if data, err := os.ReadFile("/proc/version"); err == nil {
+		fields := strings.Fields(string(data))
+		if len(fields) >= 3 {
+			return Field{Name: "kernel", Text: fields[2]}, nil
+		}
+	}
+
+	out, err := exec.CommandContext(ctx, "uname", "-r").Output()
+	if err != nil {
+		return Field{}, err
+	}
+
+	return Field{Name: "kernel", Text: strings.TrimSpace(string(out))}, nil
+}
@@ -0,0 +1,29 @@
+//go:build !linux
+
+package collectors
+
+import (
+	"context"
+
+	"github.com/shirou/gopsutil/v3/host"
+)
+
+func init() {
+	Register(gopsutilKernelCollector{})
+}
+
+// gopsutilKernelCollector reads the kernel version via gopsutil.
+type gopsutilKernelCollector struct{}
+
+func (gopsutilKernelCollector) Name() string { return "kernel" }
+
+func (gopsutilKernelCollector) Supported() bool { return true }
+
+func (gopsutilKernelCollector) Collect(ctx context.Context) (Field, error) {
+	version, err := host.KernelVersionWithContext(ctx)
+	if err != nil {
+		return Field{}, err
+	}
+
+	return Field{Name: "kernel", Text: version}, nil
+}
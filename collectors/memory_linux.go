@@ -0,0 +1,121 @@
+package collectors
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"gotcha/color"
+)
+
+func init() {
+	Register(linuxMemoryCollector{})
+	Register(linuxSwapCollector{})
+}
+
+// linuxMemoryCollector parses /proc/meminfo for RAM usage.
+type linuxMemoryCollector struct{}
+
+func (linuxMemoryCollector) Name() string { return "memory" }
+
+func (linuxMemoryCollector) Supported() bool { return true }
+
+func (linuxMemoryCollector) Collect(ctx context.Context) (Field, error) {
+	total, available, _, _, err := readMeminfo()
+	if err != nil {
+		return Field{}, err
+	}
+
+	return Field{Name: "memory", Text: parseMeminfo(total, available)}, nil
+}
+
+// linuxSwapCollector parses /proc/meminfo for swap usage.
+type linuxSwapCollector struct{}
+
+func (linuxSwapCollector) Name() string { return "swap" }
+
+func (linuxSwapCollector) Supported() bool { return true }
+
+func (linuxSwapCollector) Collect(ctx context.Context) (Field, error) {
+	_, _, swapTotal, swapFree, err := readMeminfo()
+	if err != nil {
+		return Field{}, err
+	}
+
+	return Field{Name: "swap", Text: parseMeminfo(swapTotal, swapFree)}, nil
+}
+
+// MemorySnapshot returns the typed RAM usage consumed by the render
+// package's structured formats.
+func MemorySnapshot(ctx context.Context) (MemoryStats, error) {
+	total, available, _, _, err := readMeminfo()
+	if err != nil {
+		return MemoryStats{}, err
+	}
+	if total == 0 {
+		return MemoryStats{}, errNotFound
+	}
+
+	totalBytes := total * 1024
+	availableBytes := available * 1024
+	usedBytes := totalBytes - availableBytes
+
+	return MemoryStats{
+		TotalBytes:     totalBytes,
+		UsedBytes:      usedBytes,
+		AvailableBytes: availableBytes,
+		UsedPercent:    float64(usedBytes) / float64(totalBytes) * 100,
+	}, nil
+}
+
+func readMeminfo() (total, available, swapTotal, swapFree uint64, err error) {
+	meminfo, err := os.ReadFile("/proc/meminfo")
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	for line := range bytes.SplitSeq(meminfo, []byte{'\n'}) {
+		if val, ok := bytes.CutPrefix(line, []byte("MemTotal:")); ok {
+			total, _ = strconv.ParseUint(string(bytes.Fields(bytes.TrimSpace(val))[0]), 10, 64)
+		} else if val, ok := bytes.CutPrefix(line, []byte("MemAvailable:")); ok {
+			available, _ = strconv.ParseUint(string(bytes.Fields(bytes.TrimSpace(val))[0]), 10, 64)
+		} else if val, ok := bytes.CutPrefix(line, []byte("SwapTotal:")); ok {
+			swapTotal, _ = strconv.ParseUint(string(bytes.Fields(bytes.TrimSpace(val))[0]), 10, 64)
+		} else if val, ok := bytes.CutPrefix(line, []byte("SwapFree:")); ok {
+			swapFree, _ = strconv.ParseUint(string(bytes.Fields(bytes.TrimSpace(val))[0]), 10, 64)
+		}
+	}
+
+	return total, available, swapTotal, swapFree, nil
+}
+
+// parseMeminfo formats used / total with the used percent colored red at
+// 80% and yellow at 50%: total and available are in KiB, as reported by
+// /proc/meminfo.
+func parseMeminfo(total, available uint64) string {
+	if total == 0 {
+		return unknown
+	}
+	totalBytes := total * 1024
+	availableBytes := available * 1024
+	usedBytes := totalBytes - availableBytes
+	usedPct := float64(usedBytes) / float64(totalBytes) * 100
+
+	var usageColor string
+	switch {
+	case usedPct >= 80:
+		usageColor = color.BrightRed
+	case usedPct >= 50:
+		usageColor = color.BrightYellow
+	default:
+		usageColor = color.BrightGreen
+	}
+
+	return fmt.Sprintf("%s / %s (%s used)",
+		humanBytes(usedBytes),
+		humanBytes(totalBytes),
+		color.Colorize(fmt.Sprintf("%.1f%%", usedPct), usageColor),
+	)
+}
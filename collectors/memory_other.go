@@ -0,0 +1,87 @@
+//go:build !linux
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shirou/gopsutil/v3/mem"
+	"gotcha/color"
+)
+
+func init() {
+	Register(gopsutilMemoryCollector{})
+	Register(gopsutilSwapCollector{})
+}
+
+// gopsutilMemoryCollector reads RAM usage via gopsutil.
+type gopsutilMemoryCollector struct{}
+
+func (gopsutilMemoryCollector) Name() string { return "memory" }
+
+func (gopsutilMemoryCollector) Supported() bool { return true }
+
+func (gopsutilMemoryCollector) Collect(ctx context.Context) (Field, error) {
+	stat, err := mem.VirtualMemoryWithContext(ctx)
+	if err != nil {
+		return Field{}, err
+	}
+
+	return Field{Name: "memory", Text: formatUsage(stat.Used, stat.Total)}, nil
+}
+
+// gopsutilSwapCollector reads swap usage via gopsutil.
+type gopsutilSwapCollector struct{}
+
+func (gopsutilSwapCollector) Name() string { return "swap" }
+
+func (gopsutilSwapCollector) Supported() bool { return true }
+
+func (gopsutilSwapCollector) Collect(ctx context.Context) (Field, error) {
+	stat, err := mem.SwapMemoryWithContext(ctx)
+	if err != nil {
+		return Field{}, err
+	}
+
+	return Field{Name: "swap", Text: formatUsage(stat.Used, stat.Total)}, nil
+}
+
+// MemorySnapshot returns the typed RAM usage consumed by the render
+// package's structured formats.
+func MemorySnapshot(ctx context.Context) (MemoryStats, error) {
+	stat, err := mem.VirtualMemoryWithContext(ctx)
+	if err != nil {
+		return MemoryStats{}, err
+	}
+
+	return MemoryStats{
+		TotalBytes:     stat.Total,
+		UsedBytes:      stat.Used,
+		AvailableBytes: stat.Available,
+		UsedPercent:    stat.UsedPercent,
+	}, nil
+}
+
+func formatUsage(used, total uint64) string {
+	if total == 0 {
+		return unknown
+	}
+	usedPct := float64(used) / float64(total) * 100
+
+	var usageColor string
+	switch {
+	case usedPct >= 80:
+		usageColor = color.BrightRed
+	case usedPct >= 50:
+		usageColor = color.BrightYellow
+	default:
+		usageColor = color.BrightGreen
+	}
+
+	return fmt.Sprintf("%s / %s (%s used)",
+		humanBytes(used),
+		humanBytes(total),
+		color.Colorize(fmt.Sprintf("%.1f%%", usedPct), usageColor),
+	)
+}
@@ -0,0 +1,77 @@
+package collectors
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Metrics bundles the typed snapshots the render package's structured
+// formats (JSON/YAML/Prometheus) consume, gathered in addition to the
+// colored Fields used for terminal output.
+type Metrics struct {
+	Memory        MemoryStats
+	Disks         []DiskUsage
+	Batteries     []BatteryStats
+	CPU           CPUInfo
+	UptimeSeconds int
+}
+
+// Snapshot gathers the typed metrics bundle concurrently, each snapshot
+// under its own context.WithTimeout (the same defaultTimeoutMs/
+// Config["TIMEOUT_MS"] budget Run uses), so a single stuck snapshot
+// (e.g. a hung NFS statfs) can't hang the JSON/YAML/Prometheus render
+// paths. Individual failures are ignored; a zero-value field in the
+// result means that metric was unavailable.
+func Snapshot(ctx context.Context) Metrics {
+	var m Metrics
+	timeout := time.Duration(timeoutMs()) * time.Millisecond
+
+	g, gctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		cctx, cancel := context.WithTimeout(gctx, timeout)
+		defer cancel()
+		if mem, err := MemorySnapshot(cctx); err == nil {
+			m.Memory = mem
+		}
+		return nil
+	})
+	g.Go(func() error {
+		cctx, cancel := context.WithTimeout(gctx, timeout)
+		defer cancel()
+		if disks, err := DiskSnapshot(cctx); err == nil {
+			m.Disks = disks
+		}
+		return nil
+	})
+	g.Go(func() error {
+		cctx, cancel := context.WithTimeout(gctx, timeout)
+		defer cancel()
+		if batteries, err := BatterySnapshot(cctx); err == nil {
+			m.Batteries = batteries
+		}
+		return nil
+	})
+	g.Go(func() error {
+		cctx, cancel := context.WithTimeout(gctx, timeout)
+		defer cancel()
+		if cpu, err := CPUSnapshot(cctx); err == nil {
+			m.CPU = cpu
+		}
+		return nil
+	})
+	g.Go(func() error {
+		cctx, cancel := context.WithTimeout(gctx, timeout)
+		defer cancel()
+		if secs, err := UptimeSnapshot(cctx); err == nil {
+			m.UptimeSeconds = secs
+		}
+		return nil
+	})
+
+	_ = g.Wait()
+
+	return m
+}
@@ -0,0 +1,135 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const netSampleIntervalMs = 100
+
+func init() {
+	Register(linuxNetworkCollector{})
+}
+
+// linuxNetworkCollector reports every non-loopback interface's addresses,
+// link speed, MAC, and a cumulative-bytes + delta-rate RX/TX summary.
+type linuxNetworkCollector struct{}
+
+func (linuxNetworkCollector) Name() string { return "network" }
+
+func (linuxNetworkCollector) Supported() bool { return true }
+
+func (linuxNetworkCollector) Collect(ctx context.Context) (Field, error) {
+	names, err := nonLoopbackInterfaces()
+	if err != nil {
+		return Field{}, err
+	}
+	if len(names) == 0 {
+		return Field{}, errNotFound
+	}
+
+	before, err := readNetDev()
+	if err != nil {
+		return Field{}, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return Field{}, ctx.Err()
+	case <-time.After(netSampleIntervalMs * time.Millisecond):
+	}
+
+	after, err := readNetDev()
+	if err != nil {
+		return Field{}, err
+	}
+
+	var parts []string
+	for _, name := range names {
+		parts = append(parts, describeInterface(name, before[name], after[name]))
+	}
+
+	return Field{Name: "network", Text: strings.Join(parts, ", ")}, nil
+}
+
+func nonLoopbackInterfaces() ([]string, error) {
+	entries, err := os.ReadDir("/sys/class/net")
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if e.Name() == "lo" {
+			continue
+		}
+		names = append(names, e.Name())
+	}
+	return names, nil
+}
+
+func describeInterface(name string, before, after netDevStats) string {
+	var addrs []string
+	if iface, err := net.InterfaceByName(name); err == nil {
+		if ifaceAddrs, err := iface.Addrs(); err == nil {
+			for _, a := range ifaceAddrs {
+				addrs = append(addrs, a.String())
+			}
+		}
+	}
+
+	mac := ""
+	if iface, err := net.InterfaceByName(name); err == nil {
+		mac = iface.HardwareAddr.String()
+	}
+
+	speed := "unknown speed"
+	if mbps, err := readUintFile(filepath.Join("/sys/class/net", name, "speed")); err == nil {
+		speed = fmt.Sprintf("%d Mb/s", mbps)
+	}
+
+	rxRate := (after.rxBytes - before.rxBytes) * 1000 / netSampleIntervalMs
+	txRate := (after.txBytes - before.txBytes) * 1000 / netSampleIntervalMs
+
+	return fmt.Sprintf("%s (%s, %s, %s): %s / %s total, %s/s down, %s/s up",
+		name, mac, speed, strings.Join(addrs, " "),
+		humanBytes(after.rxBytes), humanBytes(after.txBytes),
+		humanBytes(rxRate), humanBytes(txRate),
+	)
+}
+
+type netDevStats struct {
+	rxBytes, txBytes uint64
+}
+
+// readNetDev parses /proc/net/dev into per-interface RX/TX byte counts.
+func readNetDev() (map[string]netDevStats, error) {
+	data, err := os.ReadFile("/proc/net/dev")
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]netDevStats)
+	for _, line := range strings.Split(string(data), "\n") {
+		iface, rest, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		iface = strings.TrimSpace(iface)
+		fields := strings.Fields(rest)
+		if len(fields) < 9 {
+			continue
+		}
+		rx, _ := strconv.ParseUint(fields[0], 10, 64)
+		tx, _ := strconv.ParseUint(fields[8], 10, 64)
+		out[iface] = netDevStats{rxBytes: rx, txBytes: tx}
+	}
+
+	return out, nil
+}
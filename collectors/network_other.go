@@ -0,0 +1,87 @@
+//go:build !linux
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/net"
+)
+
+func init() {
+	Register(gopsutilNetworkCollector{})
+}
+
+const netSampleIntervalMs = 100
+
+// gopsutilNetworkCollector reports per-interface addresses and a
+// delta-rate RX/TX summary via gopsutil.
+type gopsutilNetworkCollector struct{}
+
+func (gopsutilNetworkCollector) Name() string { return "network" }
+
+func (gopsutilNetworkCollector) Supported() bool { return true }
+
+func (gopsutilNetworkCollector) Collect(ctx context.Context) (Field, error) {
+	ifaces, err := net.InterfacesWithContext(ctx)
+	if err != nil {
+		return Field{}, err
+	}
+
+	before, err := net.IOCountersWithContext(ctx, true)
+	if err != nil {
+		return Field{}, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return Field{}, ctx.Err()
+	case <-time.After(netSampleIntervalMs * time.Millisecond):
+	}
+
+	after, err := net.IOCountersWithContext(ctx, true)
+	if err != nil {
+		return Field{}, err
+	}
+	afterByName := make(map[string]net.IOCountersStat, len(after))
+	for _, s := range after {
+		afterByName[s.Name] = s
+	}
+
+	var parts []string
+	for _, iface := range ifaces {
+		if slices.Contains(iface.Flags, "loopback") {
+			continue
+		}
+
+		var addrs []string
+		for _, a := range iface.Addrs {
+			addrs = append(addrs, a.Addr)
+		}
+
+		var rxRate, txRate uint64
+		for _, b := range before {
+			if b.Name != iface.Name {
+				continue
+			}
+			a := afterByName[iface.Name]
+			rxRate = (a.BytesRecv - b.BytesRecv) * 1000 / netSampleIntervalMs
+			txRate = (a.BytesSent - b.BytesSent) * 1000 / netSampleIntervalMs
+		}
+
+		parts = append(parts, fmt.Sprintf("%s (%s, %s): %s/s down, %s/s up",
+			iface.Name, iface.HardwareAddr, strings.Join(addrs, " "),
+			humanBytes(rxRate), humanBytes(txRate),
+		))
+	}
+
+	if len(parts) == 0 {
+		return Field{}, errNotFound
+	}
+
+	return Field{Name: "network", Text: strings.Join(parts, ", ")}, nil
+}
@@ -0,0 +1,87 @@
+package collectors
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+func init() {
+	Register(linuxPkgCountsCollector{})
+}
+
+// linuxPkgCountsCollector tallies installed packages across whichever of
+// nix, dpkg, rpm, pacman, and flatpak are present on the host.
+type linuxPkgCountsCollector struct{}
+
+func (linuxPkgCountsCollector) Name() string { return "packages" }
+
+func (linuxPkgCountsCollector) Supported() bool { return true }
+
+func (linuxPkgCountsCollector) Collect(ctx context.Context) (Field, error) {
+	counts := make(map[string]int)
+
+	pathExists := func(path string) bool {
+		_, err := os.Stat(path)
+		return err == nil
+	}
+
+	// nix
+	if pathExists("/run/current-system/sw/bin") {
+		if entries, err := os.ReadDir("/run/current-system/sw/bin"); err == nil {
+			counts["nix"] = len(entries)
+		}
+	}
+
+	// dpkg
+	if pathExists("/usr/bin/dpkg-query") {
+		cmd := exec.CommandContext(ctx, "/usr/bin/dpkg-query", "-f", ".", "-W")
+		if out, err := cmd.Output(); err == nil {
+			counts["dpkg"] = len(out)
+		}
+	}
+
+	// rpm
+	if pathExists("/usr/bin/rpm") {
+		cmd := exec.CommandContext(ctx, "/usr/bin/rpm", "-qa")
+		if out, err := cmd.Output(); err == nil {
+			counts["rpm"] = bytes.Count(out, []byte{'\n'})
+		}
+	}
+
+	// pacman
+	if pacmanPkgCount, err := pkgCount("/var/lib/pacman/local"); err == nil {
+		counts["pacman"] = pacmanPkgCount
+	}
+
+	// flatpak
+	if flatpakPkgCount, err := pkgCount("/var/lib/flatpak/app/"); err == nil {
+		counts["flatpak"] = flatpakPkgCount
+	}
+
+	s := ""
+	for k, v := range counts {
+		if s != "" {
+			s += ", "
+		}
+		s += fmt.Sprintf("%s - %d", k, v)
+	}
+
+	return Field{Name: "packages", Text: s}, nil
+}
+
+func pkgCount(dbPath string) (int, error) {
+	files, err := os.ReadDir(dbPath)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, file := range files {
+		if file.Type().IsDir() && file.Name()[0] != '.' {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,22 @@
+//go:build !linux
+
+package collectors
+
+import "context"
+
+func init() {
+	Register(unsupportedPkgCountsCollector{})
+}
+
+// unsupportedPkgCountsCollector is registered on non-Linux platforms;
+// gotcha has no package-manager probes for macOS/Windows yet and
+// gopsutil does not expose package counts.
+type unsupportedPkgCountsCollector struct{}
+
+func (unsupportedPkgCountsCollector) Name() string { return "packages" }
+
+func (unsupportedPkgCountsCollector) Supported() bool { return false }
+
+func (unsupportedPkgCountsCollector) Collect(ctx context.Context) (Field, error) {
+	return Field{}, errNotFound
+}
@@ -0,0 +1,49 @@
+package collectors
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const defaultTimeoutMs = 500
+
+// Run executes every supported, non-disabled collector concurrently,
+// each under its own context.WithTimeout (defaultTimeoutMs, overridable
+// via Config["TIMEOUT_MS"]), and returns their Fields in registration
+// order. A collector that errors or times out renders as "unknown"
+// instead of dropping out of the result or blocking the rest.
+func Run(ctx context.Context, isDisabled func(name string) bool) []Field {
+	active := All(isDisabled)
+	fields := make([]Field, len(active))
+	timeout := time.Duration(timeoutMs()) * time.Millisecond
+
+	g, gctx := errgroup.WithContext(ctx)
+	for i, c := range active {
+		g.Go(func() error {
+			cctx, cancel := context.WithTimeout(gctx, timeout)
+			defer cancel()
+
+			field, err := c.Collect(cctx)
+			if err != nil {
+				field = Field{Name: c.Name(), Text: unknown}
+			}
+			fields[i] = field
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return fields
+}
+
+func timeoutMs() int {
+	if v, ok := Config["TIMEOUT_MS"]; ok {
+		if ms, err := strconv.Atoi(v); err == nil && ms > 0 {
+			return ms
+		}
+	}
+	return defaultTimeoutMs
+}
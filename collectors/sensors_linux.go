@@ -0,0 +1,116 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gotcha/color"
+)
+
+func init() {
+	Register(linuxSensorsCollector{})
+}
+
+// linuxSensorsCollector walks /sys/class/hwmon/hwmon*/ and reports every
+// temp*_input, fan*_input, and in*_input reading, grouped by chip name.
+type linuxSensorsCollector struct{}
+
+func (linuxSensorsCollector) Name() string { return "sensors" }
+
+func (linuxSensorsCollector) Supported() bool { return true }
+
+func (linuxSensorsCollector) Collect(ctx context.Context) (Field, error) {
+	chipDirs, err := filepath.Glob("/sys/class/hwmon/hwmon*")
+	if err != nil {
+		return Field{}, err
+	}
+	if len(chipDirs) == 0 {
+		return Field{}, errNotFound
+	}
+
+	var chips []string
+	for _, dir := range chipDirs {
+		if readings := describeChip(dir); readings != "" {
+			chips = append(chips, readings)
+		}
+	}
+	if len(chips) == 0 {
+		return Field{}, errNotFound
+	}
+
+	return Field{Name: "sensors", Text: strings.Join(chips, " | ")}, nil
+}
+
+var inputRe = regexp.MustCompile(`^(temp|fan|in)([0-9]+)_input$`)
+
+func describeChip(dir string) string {
+	name := filepath.Base(dir)
+	if data, err := os.ReadFile(filepath.Join(dir, "name")); err == nil {
+		name = strings.TrimSpace(string(data))
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+
+	var readings []string
+	for _, e := range entries {
+		m := inputRe.FindStringSubmatch(e.Name())
+		if m == nil {
+			continue
+		}
+		kind, idx := m[1], m[2]
+
+		raw, err := readUintFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			continue
+		}
+
+		switch kind {
+		case "temp":
+			readings = append(readings, describeTemp(dir, idx, raw))
+		case "fan":
+			readings = append(readings, fmt.Sprintf("fan%s %d RPM", idx, raw))
+		case "in":
+			readings = append(readings, fmt.Sprintf("in%s %.2fV", idx, float64(raw)/1000))
+		}
+	}
+
+	if len(readings) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s: %s", name, strings.Join(readings, ", "))
+}
+
+// describeTemp formats a temp*_input reading, colored by that sensor's
+// own temp*_crit/temp*_max thresholds when present, falling back to the
+// same 50/80 thresholds parseMeminfo uses.
+func describeTemp(dir, idx string, milliCelsius uint64) string {
+	celsius := float64(milliCelsius) / 1000
+
+	warn, crit := 50.0, 80.0
+	if v, err := readUintFile(filepath.Join(dir, "temp"+idx+"_max")); err == nil {
+		warn = float64(v) / 1000
+	}
+	if v, err := readUintFile(filepath.Join(dir, "temp"+idx+"_crit")); err == nil {
+		crit = float64(v) / 1000
+	}
+
+	var c string
+	switch {
+	case celsius >= crit:
+		c = color.BrightRed
+	case celsius >= warn:
+		c = color.BrightYellow
+	default:
+		c = color.BrightGreen
+	}
+
+	return fmt.Sprintf("temp%s %s", idx, color.Colorize(fmt.Sprintf("%.1f°C", celsius), c))
+}
@@ -0,0 +1,42 @@
+//go:build !linux
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/shirou/gopsutil/v3/host"
+)
+
+func init() {
+	Register(gopsutilSensorsCollector{})
+}
+
+// gopsutilSensorsCollector reports temperature sensors via gopsutil.
+// gopsutil has no fan or voltage rail equivalent off Linux, so this
+// backend covers temperatures only.
+type gopsutilSensorsCollector struct{}
+
+func (gopsutilSensorsCollector) Name() string { return "sensors" }
+
+func (gopsutilSensorsCollector) Supported() bool { return true }
+
+func (gopsutilSensorsCollector) Collect(ctx context.Context) (Field, error) {
+	temps, err := host.SensorsTemperaturesWithContext(ctx)
+	if err != nil {
+		return Field{}, err
+	}
+	if len(temps) == 0 {
+		return Field{}, errNotFound
+	}
+
+	parts := make([]string, 0, len(temps))
+	for _, t := range temps {
+		text := colorizeByPct(fmt.Sprintf("%.1f°C", t.Temperature), t.Temperature)
+		parts = append(parts, fmt.Sprintf("%s %s", t.SensorKey, text))
+	}
+
+	return Field{Name: "sensors", Text: strings.Join(parts, ", ")}, nil
+}
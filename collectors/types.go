@@ -0,0 +1,33 @@
+package collectors
+
+// MemoryStats is the typed form of the memory collector's output,
+// consumed by the render package's structured formats alongside the
+// colored Field used for terminal output.
+type MemoryStats struct {
+	TotalBytes     uint64
+	UsedBytes      uint64
+	AvailableBytes uint64
+	UsedPercent    float64
+}
+
+// BatteryStats is the typed form of a single battery's reading.
+type BatteryStats struct {
+	Name            string
+	CapacityPct     float64
+	Status          string
+	HealthPct       float64
+	TimeToStateSecs int
+}
+
+// CPUInfo is the typed form of the CPU collector's output.
+type CPUInfo struct {
+	Model          string
+	LogicalCores   int
+	PhysicalCores  int
+	CurrentMHz     int
+	MaxMHz         int
+	UtilizationPct float64
+	Load1          float64
+	Load5          float64
+	Load15         float64
+}
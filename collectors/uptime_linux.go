@@ -0,0 +1,45 @@
+package collectors
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	Register(linuxUptimeCollector{})
+}
+
+// linuxUptimeCollector parses the uptime in seconds out of /proc/uptime.
+type linuxUptimeCollector struct{}
+
+func (linuxUptimeCollector) Name() string { return "uptime" }
+
+func (linuxUptimeCollector) Supported() bool { return true }
+
+func (linuxUptimeCollector) Collect(ctx context.Context) (Field, error) {
+	secs, err := UptimeSnapshot(ctx)
+	if err != nil {
+		return Field{}, err
+	}
+
+	return Field{Name: "uptime", Text: formatDuration(secs)}, nil
+}
+
+// UptimeSnapshot returns the typed uptime in seconds consumed by the
+// render package's structured formats.
+func UptimeSnapshot(ctx context.Context) (int, error) {
+	up, err := os.ReadFile("/proc/uptime")
+	if err != nil {
+		return 0, err
+	}
+
+	uptime := strings.Split(string(up), " ")[0]
+	secs, err := strconv.ParseFloat(uptime, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(secs), nil
+}
@@ -0,0 +1,40 @@
+//go:build !linux
+
+package collectors
+
+import (
+	"context"
+
+	"github.com/shirou/gopsutil/v3/host"
+)
+
+func init() {
+	Register(gopsutilUptimeCollector{})
+}
+
+// gopsutilUptimeCollector reads uptime via gopsutil.
+type gopsutilUptimeCollector struct{}
+
+func (gopsutilUptimeCollector) Name() string { return "uptime" }
+
+func (gopsutilUptimeCollector) Supported() bool { return true }
+
+func (gopsutilUptimeCollector) Collect(ctx context.Context) (Field, error) {
+	secs, err := UptimeSnapshot(ctx)
+	if err != nil {
+		return Field{}, err
+	}
+
+	return Field{Name: "uptime", Text: formatDuration(secs)}, nil
+}
+
+// UptimeSnapshot returns the typed uptime in seconds consumed by the
+// render package's structured formats.
+func UptimeSnapshot(ctx context.Context) (int, error) {
+	secs, err := host.UptimeWithContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(secs), nil
+}
@@ -0,0 +1,212 @@
+// Package pciids resolves PCI vendor/device (and optionally subsystem)
+// IDs to human-readable names out of the hwdata pci.ids database. The
+// file is mmap'd rather than read into the heap, and a lookup index is
+// built once and cached so repeated GPU/USB/etc. lookups only scan the
+// handful of lines belonging to the relevant vendor instead of
+// rescanning the ~1.5MB file from the top every time.
+package pciids
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"golang.org/x/exp/mmap"
+)
+
+// DefaultPath is the on-disk location of the hwdata pci.ids database.
+const DefaultPath = "/usr/share/hwdata/pci.ids"
+
+var errNotFound = errors.New("not found")
+
+// vendorEntry records where a vendor's device lines start and end in the
+// mmap'd file, plus the vendor's own name.
+type vendorEntry struct {
+	start, end int
+	name       string
+}
+
+// index is the parsed, cached view of pci.ids.
+type index struct {
+	r       *mmap.ReaderAt
+	vendors map[uint16]vendorEntry
+}
+
+var (
+	once    sync.Once
+	shared  *index
+	loadErr error
+)
+
+// get returns the process-wide index, building it from DefaultPath on
+// first use.
+func get() (*index, error) {
+	once.Do(func() {
+		shared, loadErr = build(DefaultPath)
+	})
+	return shared, loadErr
+}
+
+// GetDeviceName resolves vendor/device (and, when non-zero,
+// subvendor/subdevice) to a name such as "NVIDIA Corporation GeForce RTX
+// 4070" or, when a matching subsystem line exists,
+// "NVIDIA Corporation GeForce RTX 4070 [ASUS TUF]".
+func GetDeviceName(vendor, device, subvendor, subdevice uint16) (string, error) {
+	idx, err := get()
+	if err != nil {
+		return "", err
+	}
+	return idx.lookup(vendor, device, subvendor, subdevice)
+}
+
+func (idx *index) lookup(vendorID, deviceID, subvendorID, subdeviceID uint16) (string, error) {
+	ve, ok := idx.vendors[vendorID]
+	if !ok {
+		return "", errNotFound
+	}
+
+	deviceHex := []byte(fmt.Sprintf("%04x", deviceID))
+	subsystemHex := []byte(fmt.Sprintf("%04x %04x", subvendorID, subdeviceID))
+	wantSubsystem := subvendorID != 0 || subdeviceID != 0
+
+	var deviceName string
+	var subsystemName string
+	inMatchedDevice := false
+
+	lineStart := ve.start
+	for i := ve.start; i <= ve.end; i++ {
+		if i != ve.end && idx.r.At(i) != '\n' {
+			continue
+		}
+		lineEnd := i
+		if lineEnd > lineStart {
+			tabs := idx.countLeadingTabs(lineStart, lineEnd)
+			switch tabs {
+			case 1:
+				inMatchedDevice = idx.lineHasPrefix(lineStart+1, lineEnd, deviceHex)
+				if inMatchedDevice {
+					deviceName = idx.textAfterID(lineStart+1, lineEnd, len(deviceHex))
+				}
+			case 2:
+				if inMatchedDevice && wantSubsystem && idx.lineHasPrefix(lineStart+2, lineEnd, subsystemHex) {
+					subsystemName = idx.textAfterID(lineStart+2, lineEnd, len(subsystemHex))
+				}
+			default:
+				inMatchedDevice = false
+			}
+		}
+		lineStart = i + 1
+	}
+
+	if deviceName == "" {
+		return "", errNotFound
+	}
+	if wantSubsystem && subsystemName != "" {
+		return fmt.Sprintf("%s %s [%s]", ve.name, deviceName, subsystemName), nil
+	}
+
+	return fmt.Sprintf("%s %s", ve.name, deviceName), nil
+}
+
+func (idx *index) countLeadingTabs(start, end int) int {
+	n := 0
+	for i := start; i < end && idx.r.At(i) == '\t'; i++ {
+		n++
+	}
+	return n
+}
+
+// lineHasPrefix reports whether the bytes at [from, end) start with
+// want followed by a space.
+func (idx *index) lineHasPrefix(from, end int, want []byte) bool {
+	if end-from <= len(want) || idx.r.At(from+len(want)) != ' ' {
+		return false
+	}
+	for k, b := range want {
+		if idx.r.At(from+k) != b {
+			return false
+		}
+	}
+	return true
+}
+
+// textAfterID returns the text following an idLen-byte ID and the spaces
+// after it, e.g. "Some Name" in "abcd  Some Name".
+func (idx *index) textAfterID(from, end, idLen int) string {
+	start := from + idLen + 1
+	for start < end && idx.r.At(start) == ' ' {
+		start++
+	}
+	buf := make([]byte, end-start)
+	for i := range buf {
+		buf[i] = idx.r.At(start + i)
+	}
+	return string(buf)
+}
+
+// build scans path once, recording each vendor's byte offset range so
+// later lookups can jump straight to it.
+func build(path string) (*index, error) {
+	r, err := mmap.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	n := r.Len()
+	vendors := make(map[uint16]vendorEntry)
+
+	var curID uint16
+	var curName string
+	var curStart int
+	haveVendor := false
+	lineStart := 0
+
+	for i := 0; i <= n; i++ {
+		if i != n && r.At(i) != '\n' {
+			continue
+		}
+		lineEnd := i
+		if lineEnd > lineStart && r.At(lineStart) != '#' && r.At(lineStart) != '\t' {
+			if haveVendor {
+				vendors[curID] = vendorEntry{start: curStart, end: lineStart, name: curName}
+				haveVendor = false
+			}
+			if id, name, ok := parseVendorLine(r, lineStart, lineEnd); ok {
+				curID, curName, curStart, haveVendor = id, name, lineEnd+1, true
+			}
+		}
+		lineStart = i + 1
+	}
+	if haveVendor {
+		vendors[curID] = vendorEntry{start: curStart, end: n, name: curName}
+	}
+
+	return &index{r: r, vendors: vendors}, nil
+}
+
+func parseVendorLine(r *mmap.ReaderAt, start, end int) (id uint16, name string, ok bool) {
+	if end-start <= 5 || r.At(start+4) != ' ' {
+		return 0, "", false
+	}
+
+	idBytes := make([]byte, 4)
+	for k := range idBytes {
+		idBytes[k] = r.At(start + k)
+	}
+	parsed, err := strconv.ParseUint(string(idBytes), 16, 16)
+	if err != nil {
+		return 0, "", false
+	}
+
+	nameStart := start + 5
+	for nameStart < end && r.At(nameStart) == ' ' {
+		nameStart++
+	}
+	nameBytes := make([]byte, end-nameStart)
+	for k := range nameBytes {
+		nameBytes[k] = r.At(nameStart + k)
+	}
+
+	return uint16(parsed), string(nameBytes), true
+}
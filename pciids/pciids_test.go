@@ -0,0 +1,83 @@
+package pciids
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const samplePciIds = `# comment line, skipped
+10de  NVIDIA Corporation
+	2782  AD104 [GeForce RTX 4070]
+		1043 8877  ASUS TUF
+1002  Advanced Micro Devices, Inc. [AMD/ATI]
+	73bf  Navi 21
+`
+
+func buildSample(t *testing.T) *index {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "pci.ids")
+	if err := os.WriteFile(path, []byte(samplePciIds), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	idx, err := build(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return idx
+}
+
+func TestLookupDeviceOnly(t *testing.T) {
+	idx := buildSample(t)
+
+	got, err := idx.lookup(0x10de, 0x2782, 0, 0)
+	if err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	want := "NVIDIA Corporation AD104 [GeForce RTX 4070]"
+	if got != want {
+		t.Errorf("lookup() = %q, want %q", got, want)
+	}
+}
+
+func TestLookupWithSubsystem(t *testing.T) {
+	idx := buildSample(t)
+
+	got, err := idx.lookup(0x10de, 0x2782, 0x1043, 0x8877)
+	if err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	want := "NVIDIA Corporation AD104 [GeForce RTX 4070] [ASUS TUF]"
+	if got != want {
+		t.Errorf("lookup() = %q, want %q", got, want)
+	}
+}
+
+func TestLookupSecondVendor(t *testing.T) {
+	idx := buildSample(t)
+
+	got, err := idx.lookup(0x1002, 0x73bf, 0, 0)
+	if err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	want := "Advanced Micro Devices, Inc. [AMD/ATI] Navi 21"
+	if got != want {
+		t.Errorf("lookup() = %q, want %q", got, want)
+	}
+}
+
+func TestLookupUnknownVendor(t *testing.T) {
+	idx := buildSample(t)
+
+	if _, err := idx.lookup(0xffff, 0x0000, 0, 0); err == nil {
+		t.Error("lookup() with unknown vendor: expected error, got nil")
+	}
+}
+
+func TestLookupUnknownDevice(t *testing.T) {
+	idx := buildSample(t)
+
+	if _, err := idx.lookup(0x10de, 0xffff, 0, 0); err == nil {
+		t.Error("lookup() with unknown device: expected error, got nil")
+	}
+}
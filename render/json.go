@@ -0,0 +1,16 @@
+package render
+
+import (
+	"encoding/json"
+	"io"
+
+	"gotcha/collectors"
+)
+
+// renderJSON marshals the typed metrics bundle as a JSON object, so
+// consumers get structured fields instead of colored free-text strings.
+func renderJSON(w io.Writer, metrics collectors.Metrics) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(metrics)
+}
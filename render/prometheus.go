@@ -0,0 +1,113 @@
+package render
+
+import (
+	"fmt"
+	"io"
+
+	"gotcha/collectors"
+)
+
+// metricFamily is one Prometheus metric name: a HELP/TYPE header shared
+// by every labeled sample underneath it.
+type metricFamily struct {
+	name    string
+	help    string
+	samples []sample
+}
+
+type sample struct {
+	labels map[string]string
+	value  float64
+}
+
+// renderPrometheus emits the typed metrics bundle as Prometheus text
+// exposition format gauges, so gotcha can double as a lightweight
+// node-exporter for headless boxes.
+func renderPrometheus(w io.Writer, m collectors.Metrics) error {
+	families := []metricFamily{
+		{
+			name: "gotcha_memory_used_bytes", help: "Used memory in bytes.",
+			samples: []sample{{value: float64(m.Memory.UsedBytes)}},
+		},
+		{
+			name: "gotcha_memory_total_bytes", help: "Total memory in bytes.",
+			samples: []sample{{value: float64(m.Memory.TotalBytes)}},
+		},
+		{
+			name: "gotcha_uptime_seconds", help: "System uptime in seconds.",
+			samples: []sample{{value: float64(m.UptimeSeconds)}},
+		},
+		{
+			name: "gotcha_cpu_utilization_percent", help: "Overall CPU utilization percent.",
+			samples: []sample{{value: m.CPU.UtilizationPct}},
+		},
+		{
+			name: "gotcha_cpu_load1", help: "1 minute load average.",
+			samples: []sample{{value: m.CPU.Load1}},
+		},
+		{
+			name: "gotcha_cpu_load5", help: "5 minute load average.",
+			samples: []sample{{value: m.CPU.Load5}},
+		},
+		{
+			name: "gotcha_cpu_load15", help: "15 minute load average.",
+			samples: []sample{{value: m.CPU.Load15}},
+		},
+	}
+
+	diskUsed := metricFamily{name: "gotcha_disk_used_bytes", help: "Used disk space in bytes."}
+	diskTotal := metricFamily{name: "gotcha_disk_total_bytes", help: "Total disk space in bytes."}
+	for _, d := range m.Disks {
+		labels := map[string]string{"mount": d.MountPoint}
+		diskUsed.samples = append(diskUsed.samples, sample{labels: labels, value: float64(d.Used)})
+		diskTotal.samples = append(diskTotal.samples, sample{labels: labels, value: float64(d.Total)})
+	}
+	if len(diskUsed.samples) > 0 {
+		families = append(families, diskUsed, diskTotal)
+	}
+
+	batteryCapacity := metricFamily{name: "gotcha_battery_capacity_percent", help: "Battery capacity percent."}
+	for _, b := range m.Batteries {
+		batteryCapacity.samples = append(batteryCapacity.samples,
+			sample{labels: map[string]string{"battery": b.Name}, value: b.CapacityPct})
+	}
+	if len(batteryCapacity.samples) > 0 {
+		families = append(families, batteryCapacity)
+	}
+
+	for _, f := range families {
+		if err := writeFamily(w, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFamily writes a single HELP/TYPE header followed by one line per
+// sample, as Prometheus text exposition format requires.
+func writeFamily(w io.Writer, f metricFamily) error {
+	if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n", f.name, f.help, f.name); err != nil {
+		return err
+	}
+	for _, s := range f.samples {
+		if _, err := fmt.Fprintf(w, "%s%s %v\n", f.name, labelString(s.labels), s.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func labelString(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	s := ""
+	for k, v := range labels {
+		if s != "" {
+			s += ","
+		}
+		s += fmt.Sprintf("%s=%q", k, v)
+	}
+	return "{" + s + "}"
+}
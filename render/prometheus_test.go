@@ -0,0 +1,65 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWriteFamilySingleHeaderPerFamily(t *testing.T) {
+	f := metricFamily{
+		name: "gotcha_disk_used_bytes",
+		help: "Used disk space in bytes.",
+		samples: []sample{
+			{labels: map[string]string{"mount": "/boot"}, value: 100},
+			{labels: map[string]string{"mount": "/"}, value: 200},
+		},
+	}
+
+	var sb strings.Builder
+	if err := writeFamily(&sb, f); err != nil {
+		t.Fatalf("writeFamily: %v", err)
+	}
+	out := sb.String()
+
+	if n := strings.Count(out, "# HELP gotcha_disk_used_bytes"); n != 1 {
+		t.Errorf("HELP line count = %d, want 1\noutput:\n%s", n, out)
+	}
+	if n := strings.Count(out, "# TYPE gotcha_disk_used_bytes"); n != 1 {
+		t.Errorf("TYPE line count = %d, want 1\noutput:\n%s", n, out)
+	}
+	if !strings.Contains(out, `gotcha_disk_used_bytes{mount="/boot"} 100`) {
+		t.Errorf("missing /boot sample line, output:\n%s", out)
+	}
+	if !strings.Contains(out, `gotcha_disk_used_bytes{mount="/"} 200`) {
+		t.Errorf("missing / sample line, output:\n%s", out)
+	}
+}
+
+func TestWriteFamilyNoLabels(t *testing.T) {
+	f := metricFamily{
+		name:    "gotcha_uptime_seconds",
+		help:    "System uptime in seconds.",
+		samples: []sample{{value: 42}},
+	}
+
+	var sb strings.Builder
+	if err := writeFamily(&sb, f); err != nil {
+		t.Fatalf("writeFamily: %v", err)
+	}
+
+	want := "# HELP gotcha_uptime_seconds System uptime in seconds.\n" +
+		"# TYPE gotcha_uptime_seconds gauge\n" +
+		"gotcha_uptime_seconds 42\n"
+	if got := sb.String(); got != want {
+		t.Errorf("writeFamily() = %q, want %q", got, want)
+	}
+}
+
+func TestLabelString(t *testing.T) {
+	if got := labelString(nil); got != "" {
+		t.Errorf("labelString(nil) = %q, want empty string", got)
+	}
+	if got := labelString(map[string]string{"battery": "BAT0"}); got != `{battery="BAT0"}` {
+		t.Errorf("labelString() = %q, want %q", got, `{battery="BAT0"}`)
+	}
+}
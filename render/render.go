@@ -0,0 +1,49 @@
+// Package render turns a collection run into one of several output
+// formats: the default colored terminal text, or a machine-readable
+// JSON, YAML, or Prometheus text-exposition format selected via the
+// --format flag.
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"gotcha/collectors"
+)
+
+// Format is one of the supported output formats.
+type Format string
+
+const (
+	FormatText       Format = "text"
+	FormatJSON       Format = "json"
+	FormatYAML       Format = "yaml"
+	FormatPrometheus Format = "prometheus"
+)
+
+// ParseFormat validates a --format flag value.
+func ParseFormat(s string) (Format, error) {
+	f := Format(strings.ToLower(s))
+	switch f {
+	case FormatText, FormatJSON, FormatYAML, FormatPrometheus:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown format %q", s)
+	}
+}
+
+// Render writes the collected fields (and, for structured formats, the
+// typed metrics bundle) to w in the requested format.
+func Render(w io.Writer, format Format, fields []collectors.Field, metrics collectors.Metrics) error {
+	switch format {
+	case FormatJSON:
+		return renderJSON(w, metrics)
+	case FormatYAML:
+		return renderYAML(w, metrics)
+	case FormatPrometheus:
+		return renderPrometheus(w, metrics)
+	default:
+		return renderText(w, fields)
+	}
+}
@@ -0,0 +1,19 @@
+package render
+
+import (
+	"fmt"
+	"io"
+
+	"gotcha/collectors"
+)
+
+// renderText writes one "Name: Text" line per field, preserving whatever
+// ANSI color codes the collector already baked into Text.
+func renderText(w io.Writer, fields []collectors.Field) error {
+	for _, f := range fields {
+		if _, err := fmt.Fprintf(w, "%s: %s\n", f.Name, f.Text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package render
+
+import (
+	"io"
+
+	"gotcha/collectors"
+
+	"gopkg.in/yaml.v3"
+)
+
+// renderYAML marshals the typed metrics bundle as YAML, so consumers
+// get structured fields instead of colored free-text strings.
+func renderYAML(w io.Writer, metrics collectors.Metrics) error {
+	enc := yaml.NewEncoder(w)
+	defer enc.Close()
+	return enc.Encode(metrics)
+}